@@ -12,8 +12,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -21,6 +19,10 @@ const (
 	AcarsRequestUrl = "http://www.hoppie.nl/acars/system/connect.html"
 	// Poll Interval when polling new messages (Seconds)
 	DefaultPollInterval = 60
+	// Default per-request timeout, MaxRetries, and RetryBackoff for a manager's own requests
+	DefaultRequestTimeout = 30 * time.Second
+	DefaultMaxRetries     = 3
+	DefaultRetryBackoff   = 500 * time.Millisecond
 )
 
 type ACARSManager struct {
@@ -39,7 +41,23 @@ type ACARSManager struct {
 	// Options for the ACARSManager
 	//
 	// Allows certain 'features' to be set like ADS-C Reporting and custom timeouts/intervals
-	opts   ACARSManagerOptions
+	opts ACARSManagerOptions
+	// Sinks registered via AddSink, fanned out to on every inbound/outbound message
+	sinks []Sink
+	// Subscribe subscriptions, fanned out to (non-blocking) on every inbound message
+	subsMutex   sync.Mutex
+	subscribers map[uint64]*subscription
+	subID       uint64
+	// Backoff strategy driving both the poll loop's idle growth and reconnect attempts
+	backoff backoffStrategy
+	// Backoff strategy applied between retries of a transient raw request failure
+	retryBackoff backoffStrategy
+	// Non-nil when HOPPIE_REATTACH was present at construction, attaching to a session
+	// owned by another process instead of logging on ourselves
+	reattach   *ReattachConfig
+	httpClient *http.Client
+	// Non-nil when ACARSManagerOptions.AdsCReporting is enabled
+	adsc   *ADSCReporter
 	Ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -51,8 +69,70 @@ type ACARSManagerOptions struct {
 	//
 	// If nil, will wait an indefinite amount of time before state change or otherwise
 	cpdlcLogonTimeout *int
-	// Set a custom ACARS polling interval (Default is 60 seconds)
-	pollingInterval int
+	// Backoff strategy used for polling and reconnects. See PollInterval for the legacy
+	// fixed-interval equivalent.
+	backoff BackoffConfig
+	// Overrides AcarsRequestUrl for this manager's own requests, e.g. to point at an
+	// in-process hoppietest server instead of the real hoppie.nl
+	baseURL string
+	// Logger receives internal logging (polling started, logon successful, waiting for
+	// logon, disconnected, etc). Defaults to zerolog's global logger.
+	logger Logger
+	// HTTP client used for this manager's own requests. Defaults to http.DefaultClient.
+	httpClient *http.Client
+	// Per-request timeout for this manager's own requests. Defaults to 30 seconds.
+	requestTimeout time.Duration
+	// How many times a transient request failure (5xx, network error, transient "error
+	// {...}" payload) is retried before being surfaced. Defaults to 3.
+	maxRetries int
+	// Base delay for the exponential backoff + jitter applied between retries.
+	// Defaults to 500ms.
+	retryBackoff time.Duration
+	// Persists Session (station, lastMin, lastMrn, connection state) across restarts.
+	// Defaults to an InMemoryStateStore, i.e. no persistence beyond the process's own
+	// lifetime.
+	stateStore StateStore
+}
+
+// StateStore overrides the default InMemoryStateStore, e.g. with a FileStateStore so a
+// restarted process can Resume an in-progress CPDLC session instead of re-logging on.
+func (o *ACARSManagerOptions) StateStore(store StateStore) {
+	o.stateStore = store
+}
+
+// HTTPClient overrides the *http.Client used for this manager's own requests.
+func (o *ACARSManagerOptions) HTTPClient(client *http.Client) {
+	o.httpClient = client
+}
+
+// RequestTimeout bounds how long a single raw request may take before it is treated as
+// failed (and possibly retried).
+func (o *ACARSManagerOptions) RequestTimeout(timeout time.Duration) {
+	o.requestTimeout = timeout
+}
+
+// MaxRetries caps how many times a transient request failure is retried before
+// surfacing the error to the caller.
+func (o *ACARSManagerOptions) MaxRetries(retries int) {
+	o.maxRetries = retries
+}
+
+// RetryBackoff sets the base delay for the exponential backoff + jitter applied
+// between retries of a transient request failure.
+func (o *ACARSManagerOptions) RetryBackoff(delay time.Duration) {
+	o.retryBackoff = delay
+}
+
+// Logger injects a custom Logger, so slog, logrus, log15 or a test buffer can be used
+// instead of zerolog's global logger.
+func (o *ACARSManagerOptions) Logger(logger Logger) {
+	o.logger = logger
+}
+
+// BaseURL redirects the manager's own ACARS requests away from the real hoppie.nl,
+// e.g. towards an in-process hoppietest server for deterministic tests and examples.
+func (o *ACARSManagerOptions) BaseURL(url string) {
+	o.baseURL = url
 }
 
 func (o *ACARSManagerOptions) AdsCReporting(enable bool) {
@@ -65,13 +145,29 @@ func (o *ACARSManagerOptions) LogonTimeout(time int) {
 
 // Set polling interval for ACARS listen
 //
-// Time value needs to be in seconds, so for example SetPollInterval(30) would be 30 seconds
+// Time value needs to be in seconds, so for example PollInterval(30) would be 30 seconds.
+// This is a fixed-interval shorthand for Backoff; for adaptive idle backoff use Backoff directly.
 func (o *ACARSManagerOptions) PollInterval(time int) {
 	if !(time <= 0) {
-		o.pollingInterval = time
+		interval := time
+		o.backoff = BackoffConfig{
+			BaseDelay: time2Duration(interval),
+			MaxDelay:  time2Duration(interval),
+			Factor:    1,
+		}
 	}
 }
 
+func time2Duration(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+// Backoff sets the BackoffConfig used for polling and reconnect attempts, replacing the
+// DefaultBackoffConfig.
+func (o *ACARSManagerOptions) Backoff(cfg BackoffConfig) {
+	o.backoff = cfg
+}
+
 func NewACARSManager(logon string, callsign string, opts ...ACARSManagerOptions) *ACARSManager {
 	ctx, cancel := context.WithCancel(context.Background())
 	group, _ := errgroup.WithContext(ctx)
@@ -79,23 +175,68 @@ func NewACARSManager(logon string, callsign string, opts ...ACARSManagerOptions)
 	options := ACARSManagerOptions{
 		adscReporting:     false,
 		cpdlcLogonTimeout: nil,
-		pollingInterval:   DefaultPollInterval,
+		backoff:           DefaultBackoffConfig,
+		logger:            defaultLogger(),
+		requestTimeout:    DefaultRequestTimeout,
+		maxRetries:        DefaultMaxRetries,
+		retryBackoff:      DefaultRetryBackoff,
+		stateStore:        NewInMemoryStateStore(),
 	}
 
 	if len(opts) > 0 {
 		options = opts[0]
-		if options.adscReporting {
-			log.Info().
-				Bool("ADS-C Reporting", true).
-				Msg("Manager Option Added")
+
+		if options.backoff == (BackoffConfig{}) {
+			options.backoff = DefaultBackoffConfig
+		}
+
+		if options.logger == nil {
+			options.logger = defaultLogger()
+		}
+
+		if options.requestTimeout == 0 {
+			options.requestTimeout = DefaultRequestTimeout
+		}
+
+		if options.retryBackoff == 0 {
+			options.retryBackoff = DefaultRetryBackoff
+		}
+
+		if options.maxRetries == 0 {
+			options.maxRetries = DefaultMaxRetries
 		}
 
-		if options.pollingInterval == 0 {
-			options.pollingInterval = DefaultPollInterval
+		if options.stateStore == nil {
+			options.stateStore = NewInMemoryStateStore()
 		}
+
+		if options.adscReporting {
+			options.logger.Info("Manager Option Added", "ADS-C Reporting", true)
+		}
+	}
+
+	reattach, err := reattachConfigFromEnv()
+	if err != nil {
+		options.logger.Warn("Ignoring invalid HOPPIE_REATTACH", "error", err)
+		reattach = nil
+	}
+
+	if reattach != nil {
+		logon = reattach.Logon
+		callsign = reattach.Sender
+		options.logger.Info("Attaching to externally managed ACARS session", "BaseURL", reattach.BaseURL)
+	}
+
+	httpClient := options.httpClient
+	if reattach != nil && reattach.Socket != "" {
+		httpClient = unixSocketClient(strings.TrimPrefix(reattach.Socket, "unix://"))
+	}
+
+	if reattach != nil && reattach.Cookie != "" {
+		httpClient = withReattachCookie(httpClient, reattach.Cookie)
 	}
 
-	return &ACARSManager{
+	manager := &ACARSManager{
 		logon:    logon,
 		callsign: &callsign,
 		messages: make(chan ACARSMessage, 1),
@@ -104,11 +245,79 @@ func NewACARSManager(logon string, callsign string, opts ...ACARSManagerOptions)
 			rx:      make(chan ConnectionState, 1),
 			lastMin: 1,
 		},
-		opts:     options,
-		ErrGroup: group,
-		Ctx:      ctx,
-		cancel:   cancel,
+		opts:        options,
+		subscribers: make(map[uint64]*subscription),
+		backoff:     newExponentialBackoff(options.backoff),
+		retryBackoff: newExponentialBackoff(BackoffConfig{
+			BaseDelay: options.retryBackoff,
+			MaxDelay:  30 * time.Second,
+			Factor:    2,
+			Jitter:    0.2,
+		}),
+		reattach:   reattach,
+		httpClient: httpClient,
+		ErrGroup:   group,
+		Ctx:        ctx,
+		cancel:     cancel,
+	}
+
+	manager.Connection.onChange = func() { manager.persistSession() }
+
+	if options.adscReporting {
+		manager.adsc = newADSCReporter(manager)
+	}
+
+	return manager
+}
+
+// persistSession saves the manager's current Session via ACARSManagerOptions.StateStore.
+// Called from ACARSConnection.onChange after every Connect/IncrementMin/PushState/
+// SetLastMrn mutation; a store error is logged rather than surfaced, since persistence
+// is best-effort and must not block the CPDLC exchange it is recording.
+func (m *ACARSManager) persistSession() {
+	station, lastMin, lastMrn, state := m.Connection.snapshot()
+
+	session := &Session{
+		Callsign: *m.callsign,
+		Station:  station,
+		LastMin:  lastMin,
+		LastMrn:  lastMrn,
+		State:    state,
 	}
+
+	if err := m.opts.stateStore.SaveSession(session); err != nil {
+		m.opts.logger.Warn("Failed to persist session", "Callsign", *m.callsign, "error", err)
+	}
+}
+
+// Resume re-enters the polling loop for a previously connected callsign using the
+// Session persisted in ACARSManagerOptions.StateStore, without re-sending REQUEST
+// LOGON, for a process restarted mid CPDLC-session.
+func (m *ACARSManager) Resume(callsign string) error {
+	session, err := m.opts.stateStore.LoadSession(callsign)
+	if err != nil {
+		return fmt.Errorf("resume: failed to load session for %s: %w", callsign, err)
+	}
+
+	if session == nil {
+		return fmt.Errorf("resume: no persisted session for %s", callsign)
+	}
+
+	m.callsign = &callsign
+	m.Connection.lastMin = session.LastMin
+	m.Connection.lastMrn = session.LastMrn
+	m.Connection.SetStation(session.Station)
+	m.Connection.PushState(session.State)
+
+	m.ErrGroup.Go(m.Listen)
+
+	return nil
+}
+
+// ADSCReporter returns the manager's ADS-C reporter, or nil if
+// ACARSManagerOptions.AdsCReporting was never enabled.
+func (m *ACARSManager) ADSCReporter() *ADSCReporter {
+	return m.adsc
 }
 
 type ConnectionState int
@@ -128,12 +337,18 @@ type ACARSConnection struct {
 	rx      chan ConnectionState
 	station *string
 	lastMin int
+	lastMrn *int
+	// onChange, if set by the owning ACARSManager, is called after every mutation below
+	// so the manager can persist the connection's Session via its StateStore.
+	onChange func()
 }
 
 func (c *ACARSConnection) SetStation(station string) {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
 	c.station = &station
+	c.mutex.Unlock()
+
+	c.notifyChange()
 }
 
 func (c *ACARSConnection) Station() *string {
@@ -142,16 +357,91 @@ func (c *ACARSConnection) Station() *string {
 
 func (c *ACARSConnection) IncrementMin() {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
 	c.lastMin++
+	c.mutex.Unlock()
+
+	c.notifyChange()
 }
 
-func (c *ACARSConnection) PushState(state ConnectionState) {
+// SetLastMrn records the last MRN seen from the connected station, e.g. from the
+// LOGON ACCEPTED reply matched in Listen, so a resumed session's Session reflects it.
+func (c *ACARSConnection) SetLastMrn(mrn *int) {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
+	c.lastMrn = mrn
+	c.mutex.Unlock()
 
+	c.notifyChange()
+}
+
+func (c *ACARSConnection) PushState(state ConnectionState) {
+	c.mutex.Lock()
 	c.state = state
+	c.mutex.Unlock()
+
 	c.rx <- state
+
+	c.notifyChange()
+}
+
+func (c *ACARSConnection) notifyChange() {
+	if c.onChange != nil {
+		c.onChange()
+	}
+}
+
+// snapshot returns station, lastMin, lastMrn and state as a single consistent view
+// under c.mutex, for persistSession to build its Session from without racing the
+// setters above (e.g. Listen's PushState/SetLastMrn against a caller goroutine's
+// CPDLCRequest->IncrementMin).
+func (c *ACARSConnection) snapshot() (station string, lastMin int, lastMrn *int, state ConnectionState) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.station != nil {
+		station = *c.station
+	}
+
+	return station, c.lastMin, c.lastMrn, c.state
+}
+
+// rawRequest sends a request on behalf of the manager, honouring ACARSManagerOptions.BaseURL
+// and, in reattach mode, the reattached session's base URL/unix socket. Transient
+// failures (5xx, network errors, transient "error {...}" payloads) are retried with
+// exponential backoff + jitter up to ACARSManagerOptions.MaxRetries before the error is
+// returned to the caller.
+func (m *ACARSManager) rawRequest(station string, messageType MessageType, content string) (string, error) {
+	baseURL := m.opts.baseURL
+	if baseURL == "" {
+		baseURL = AcarsRequestUrl
+	}
+
+	if m.reattach != nil && m.reattach.BaseURL != "" {
+		baseURL = m.reattach.BaseURL
+	}
+
+	for attempt := 0; ; attempt++ {
+		ctx := m.Ctx
+		cancel := func() {}
+		if m.opts.requestTimeout > 0 {
+			ctx, cancel = context.WithTimeout(m.Ctx, m.opts.requestTimeout)
+		}
+
+		data, err := makeRawRequestAt(ctx, m.httpClient, baseURL, m.logon, *m.callsign, station, messageType, content)
+		cancel()
+
+		if err == nil || !errors.Is(err, ErrTransientRequest) || attempt >= m.opts.maxRetries {
+			return data, err
+		}
+
+		delay := m.retryBackoff.Backoff(attempt)
+		m.opts.logger.Warn("Transient request failure, retrying", "Station", station, "Attempt", attempt+1, "Delay", delay, "error", err)
+
+		select {
+		case <-time.After(delay):
+		case <-m.Ctx.Done():
+			return "", m.Ctx.Err()
+		}
+	}
 }
 
 func (m *ACARSManager) Connect(station string) error {
@@ -162,7 +452,22 @@ func (m *ACARSManager) Connect(station string) error {
 
 	m.Connection.SetStation(station)
 
-	_, err := MakeRawRequest(m.logon, *m.callsign, station, CpdlcMessageType, MakeCPDLCPacket(
+	if m.adsc != nil {
+		m.ErrGroup.Go(func() error {
+			return m.adsc.run(m.Ctx)
+		})
+	}
+
+	// In reattach mode, the logon/poll session is owned by another process; we just
+	// attach to it and skip our own REQUEST LOGON handshake.
+	if m.reattach != nil {
+		m.ErrGroup.Go(m.Listen)
+		m.Connection.PushState(Connected)
+
+		return nil
+	}
+
+	_, err := m.rawRequest(station, CpdlcMessageType, MakeCPDLCPacket(
 		m.Connection.lastMin,
 		nil,
 		RespondRequired,
@@ -180,6 +485,9 @@ func (m *ACARSManager) Connect(station string) error {
 	return nil
 }
 
+// Close stops the manager's own goroutines and channels. In reattach mode the
+// logon/poll session itself is owned by another process, so Close never touches it -
+// it only tears down resources the manager created (its polling goroutine and channels).
 func (m *ACARSManager) Close() {
 	m.cancel()
 
@@ -197,13 +505,9 @@ func (m *ACARSManager) OnConnected(f func() error) error {
 			case Connected:
 				f()
 			case Waiting:
-				log.Info().
-					Str("Station", *m.Connection.Station()).
-					Msg("Waiting for Logon")
+				m.opts.logger.Info("Waiting for Logon", "Station", *m.Connection.Station())
 			default:
-				log.Info().
-					Str("Station", *m.Connection.Station()).
-					Msg("Connection Disconnected")
+				m.opts.logger.Info("Connection Disconnected", "Station", *m.Connection.Station())
 			}
 		case <-m.Ctx.Done():
 			return errors.New("manager context done/cancelled")
@@ -224,47 +528,48 @@ func (m *ACARSManager) Listen() error {
 		return errors.New("acars listen: invalid value provided")
 	}
 
-	// Create a ticker with a certain interval to make Hoppie happy
-	log.Debug().
-		Int("Interval", m.opts.pollingInterval).
-		Msg("Polling Started")
+	// idleRetries counts consecutive empty polls, driving the adaptive idle backoff.
+	// Any poll that yields a message resets it to zero, snapping the interval back to BaseDelay.
+	idleRetries := 0
+
+	m.opts.logger.Debug("Polling Started", "Interval", 0)
 
-	// elapsedTime := 0
-	ticker := time.NewTicker(time.Duration(m.opts.pollingInterval) * time.Second)
+	// The very first poll fires immediately rather than waiting out BaseDelay: BaseDelay
+	// is the steady-state floor between polls of an already-established session, and
+	// with DefaultBackoffConfig's 60s floor, waiting it out before the first poll would
+	// delay LOGON-ACCEPTED detection by up to a minute.
+	timer := time.NewTimer(0)
 	var timeout <-chan time.Time
 
 	if m.opts.cpdlcLogonTimeout != nil {
 		timeout = time.After(time.Duration(*m.opts.cpdlcLogonTimeout) * time.Second)
 	}
 
-	defer ticker.Stop()
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-timeout:
 			m.Connection.PushState(Disconnected)
 			return errors.New("CPDLC logon timeout reached, pushed Disconnected state")
-		case <-ticker.C:
-			// elapsedTime = elapsedTime + m.opts.pollingInterval
-
-			// if m.opts.cpdlcLogonTimeout != nil && elapsedTime >= *m.opts.cpdlcLogonTimeout {
-			// 	// CPDLC Logon timeout, push state to disconnected
-			// }
-
-			data, e := MakeRawRequest(
-				m.logon,
-				*m.callsign,
-				*m.Connection.Station(),
-				PollMessageType,
-				"",
-			)
+		case <-timer.C:
+			data, e := m.rawRequest(*m.Connection.Station(), PollMessageType, "")
 			if e != nil {
 				m.cancel()
 				return e
 			}
 
+			messages := ParseACARSMessage(data)
+			if len(messages) == 0 {
+				idleRetries++
+			} else {
+				idleRetries = 0
+			}
+
+			timer.Reset(m.backoff.Backoff(idleRetries))
+
 			// We parse any ACARS messages within the data array & check for valid logon accepted CPDLC messages to push to a connected state
-			for _, v := range ParseACARSMessage(data) {
+			for _, v := range messages {
 				if m.ConnectionState() == Waiting && v.Type == CpdlcMessageType {
 					message, e := ParseCPDLCMessage(v.Data)
 					if e != nil {
@@ -276,25 +581,42 @@ func (m *ACARSManager) Listen() error {
 						v.Sender == *m.Connection.Station() {
 
 						m.Connection.PushState(Connected)
+						m.Connection.SetLastMrn(message.Mrn)
 
-						log.Info().
-							Str("Station", v.Sender).
-							Msg("Logon Successful")
+						m.opts.logger.Info("Logon Successful", "Station", v.Sender)
 
 					} else {
-						log.Debug().
-							Str("Stored Station", *m.Connection.Station()).
-							Int("Last Recorded MIN", m.Connection.lastMin).
-							Dict("Message", zerolog.Dict().
-								Str("Sender", v.Sender).
-								Str("MRN", NilCheck(message.Mrn)).
-								Str("Data", message.Data),
-							).
-							Msg("Received reply to logon but failed match")
+						m.opts.logger.Debug("Received reply to logon but failed match",
+							"Stored Station", *m.Connection.Station(),
+							"Last Recorded MIN", m.Connection.lastMin,
+							"Sender", v.Sender,
+							"MRN", NilCheck(message.Mrn),
+							"Data", message.Data,
+						)
 					}
+
+					if m.adsc != nil {
+						m.adsc.handleContractRequest(v.Sender, message.Data)
+					}
+				} else if m.adsc != nil && v.Type == CpdlcMessageType {
+					if message, e := ParseCPDLCMessage(v.Data); e == nil {
+						m.adsc.handleContractRequest(v.Sender, message.Data)
+					}
+				} else if m.adsc != nil && v.Type == AdsCMessageType {
+					// Unlike contract requests piggybacked on a CPDLC frame, an ads-c-typed
+					// frame carries the request as its plain Data body, with no /data2/
+					// MIN/MRN/RRK framing to strip first.
+					m.adsc.handleContractRequest(v.Sender, v.Data)
 				}
 
-				m.messages <- v
+				m.dispatchToSinks(SinkDirectionInbound, v)
+				m.dispatchToSubscribers(v)
+
+				select {
+				case m.messages <- v:
+				default:
+					m.opts.logger.Debug("Recv channel full, dropping message", "Sender", v.Sender, "Type", v.Type)
+				}
 			}
 		case <-m.Ctx.Done():
 			return m.Ctx.Err()
@@ -321,11 +643,13 @@ func (m *ACARSManager) CPDLCRequest(data string, rrk ResponseRequirements) error
 		data,
 	)
 
-	_, err := MakeRawRequest(m.logon, *m.callsign, *m.Connection.Station(), CpdlcMessageType, packet)
+	_, err := m.rawRequest(*m.Connection.Station(), CpdlcMessageType, packet)
 	if err != nil {
 		return err
 	}
 
+	m.dispatchToSinks(SinkDirectionOutbound, ACARSMessage{Sender: *m.callsign, Type: CpdlcMessageType, Data: packet})
+
 	return nil
 }
 
@@ -334,7 +658,7 @@ func (m *ACARSManager) WeatherRequest(icao string, dataType WeatherRequestType)
 		return errors.New("weather request: invalid icao")
 	}
 
-	data, err := MakeRawRequest(m.logon, *m.callsign, "SERVER", InfoRequestMessageType, string(dataType)+" "+icao)
+	data, err := m.rawRequest("SERVER", InfoRequestMessageType, string(dataType)+" "+icao)
 	if err != nil {
 		return err
 	}
@@ -357,15 +681,23 @@ func (m *ACARSManager) Telex(data string, station string) error {
 		return fmt.Errorf("telex request from %s: invalid data", *m.callsign)
 	}
 
-	_, err := MakeRawRequest(m.logon, *m.callsign, station, TelexMessageType, data)
+	_, err := m.rawRequest(station, TelexMessageType, data)
 	if err != nil {
 		return err
 	}
 
+	m.dispatchToSinks(SinkDirectionOutbound, ACARSMessage{Sender: *m.callsign, Type: TelexMessageType, Data: data})
+
 	return nil
 }
 
 // Basic implementation of how the queue system would look when receiving a message from
+//
+// Recv is a legacy catch-all: every message is delivered here regardless of type or
+// sender. The channel has capacity 1 and the send is non-blocking, so a reader that
+// falls behind (or stops draining Recv entirely in favour of Subscribe) has messages
+// dropped rather than stalling Listen's poll loop. For a filtered view with its own
+// buffer and drop policy use Subscribe instead.
 func (m *ACARSManager) Recv() chan ACARSMessage {
 	return m.messages
 }
@@ -400,6 +732,15 @@ func MakeCPDLCPacket(
 	return strings.Join(packet, "/")
 }
 
+// ErrTransientRequest wraps raw request failures considered safe to retry: network
+// errors, 5xx responses, and "error {...}" payloads recognised as transient (e.g. the
+// station reporting itself busy). Check with errors.Is.
+var ErrTransientRequest = errors.New("hoppielib: transient request error")
+
+// MakeRawRequest sends a request against the real hoppie.nl ACARS system, with no
+// timeout or retry of its own. Use MakeRawRequestCtx to bound it with a context, or
+// ACARSManagerOptions' RequestTimeout/MaxRetries/RetryBackoff/HTTPClient/BaseURL for a
+// manager's own requests.
 func MakeRawRequest(
 	logon string,
 	callsign string,
@@ -407,6 +748,40 @@ func MakeRawRequest(
 	messageType MessageType,
 	content string,
 ) (string, error) {
+	return MakeRawRequestCtx(context.Background(), logon, callsign, station, messageType, content)
+}
+
+// MakeRawRequestCtx is MakeRawRequest with a context, so cancellation/deadlines abort
+// the in-flight request.
+func MakeRawRequestCtx(
+	ctx context.Context,
+	logon string,
+	callsign string,
+	station string,
+	messageType MessageType,
+	content string,
+) (string, error) {
+	return makeRawRequestAt(ctx, nil, AcarsRequestUrl, logon, callsign, station, messageType, content)
+}
+
+func makeRawRequestAt(
+	ctx context.Context,
+	client *http.Client,
+	baseURL string,
+	logon string,
+	callsign string,
+	station string,
+	messageType MessageType,
+	content string,
+) (string, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	requestParams := url.Values{
 		"logon":  {logon},
 		"from":   {callsign},
@@ -414,10 +789,16 @@ func MakeRawRequest(
 		"type":   {string(messageType)},
 		"packet": {content},
 	}
-	constructedUrl := AcarsRequestUrl + "?" + requestParams.Encode()
-	r, e := http.Get(constructedUrl)
+	constructedUrl := baseURL + "?" + requestParams.Encode()
+
+	req, e := http.NewRequestWithContext(ctx, http.MethodGet, constructedUrl, nil)
+	if e != nil {
+		return "", fmt.Errorf("failed to build raw request: %w", e)
+	}
+
+	r, e := client.Do(req)
 	if e != nil {
-		return "", fmt.Errorf("failed to send raw request: %w", e)
+		return "", fmt.Errorf("%w: failed to send raw request: %v", ErrTransientRequest, e)
 	}
 
 	defer r.Body.Close()
@@ -426,14 +807,35 @@ func MakeRawRequest(
 		return "", fmt.Errorf("could not read response body via io reader: %w", e)
 	}
 
+	if r.StatusCode >= 500 {
+		return "", fmt.Errorf("%w: hoppie acars returned status %d", ErrTransientRequest, r.StatusCode)
+	}
+
 	if strings.HasPrefix(string(data), "ok") {
 		// Good response
 		return string(data), nil
 	} else if strings.HasPrefix(string(data), "error") {
 		// Bad response
 		errorMessage := string(data)[strings.IndexRune(string(data), '{')+1 : strings.IndexRune(string(data), '}')]
+		if isTransientErrorMessage(errorMessage) {
+			return "", fmt.Errorf("%w: hoppie acars returned an error from request: %s", ErrTransientRequest, errorMessage)
+		}
 		return "", fmt.Errorf("hoppie acars returned an error from request: %s", errorMessage)
 	}
 
 	return "", nil
 }
+
+// isTransientErrorMessage heuristically recognises hoppie.nl "error {...}" payloads
+// that describe a transient condition worth retrying, rather than a permanent one
+// (e.g. an invalid logon code).
+func isTransientErrorMessage(message string) bool {
+	lower := strings.ToLower(message)
+	for _, marker := range []string{"busy", "timeout", "temporar", "unavailable"} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+
+	return false
+}
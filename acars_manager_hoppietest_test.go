@@ -0,0 +1,104 @@
+package hoppielibgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devHazz/hoppielib-go/hoppietest"
+)
+
+// fastBackoff polls quickly enough for tests to observe a state change without
+// waiting out DefaultBackoffConfig's ~60s active-poll floor.
+var fastBackoff = BackoffConfig{
+	BaseDelay: 5 * time.Millisecond,
+	MaxDelay:  5 * time.Millisecond,
+	Factor:    1,
+}
+
+func TestListenLogonAcceptedReachesConnected(t *testing.T) {
+	server := hoppietest.New()
+	defer server.Close()
+
+	const sender, receiver = "TEST123", "WLS2"
+
+	server.Enqueue(sender, hoppietest.Frame{
+		Station: receiver,
+		Type:    "cpdlc",
+		Payload: "/data2/2/1/NE/LOGON ACCEPTED",
+	})
+
+	var opts ACARSManagerOptions
+	opts.BaseURL(server.URL + "/acars/system/connect.html")
+	opts.Backoff(fastBackoff)
+	opts.Logger(NewNoopLogger())
+
+	manager := NewACARSManager("TEST", sender, opts)
+	defer manager.Close()
+
+	if err := manager.Connect(receiver); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case state := <-manager.RecvState():
+			if state == Connected {
+				return
+			}
+		case <-deadline:
+			t.Fatal("timed out waiting for Connected state")
+		}
+	}
+}
+
+func TestListenMalformedFrameSurfacesError(t *testing.T) {
+	server := hoppietest.New()
+	defer server.Close()
+
+	const sender, receiver = "TEST123", "WLS2"
+
+	server.EnqueueMalformed(sender, "not a valid cpdlc payload")
+
+	var opts ACARSManagerOptions
+	opts.BaseURL(server.URL + "/acars/system/connect.html")
+	opts.Backoff(fastBackoff)
+	opts.Logger(NewNoopLogger())
+
+	manager := NewACARSManager("TEST", sender, opts)
+	defer manager.Close()
+
+	if err := manager.Connect(receiver); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- manager.ErrGroup.Wait() }()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrInvalidCPDLCFormat) {
+			t.Fatalf("got err %v, want ErrInvalidCPDLCFormat", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for Listen to surface the malformed frame")
+	}
+}
+
+func TestGetStatusNotamsAtHoppietest(t *testing.T) {
+	server := hoppietest.New()
+	defer server.Close()
+
+	server.SetLoadPercentage(42)
+	server.SetNotams([]string{"RWY 09/27 CLOSED"})
+
+	notams, err := GetStatusNotamsAt(server.Client(), server.URL+"/acars/system/status.html")
+	if err != nil {
+		t.Fatalf("GetStatusNotamsAt: %v", err)
+	}
+
+	if len(notams) != 1 || notams[0] != "RWY 09/27 CLOSED" {
+		t.Fatalf("got notams %v, want [RWY 09/27 CLOSED]", notams)
+	}
+}
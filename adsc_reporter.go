@@ -0,0 +1,284 @@
+package hoppielibgo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ADSCReport is a single position/state snapshot supplied by the host application's
+// position provider, used to build an outgoing ADS-C report.
+type ADSCReport struct {
+	Latitude      float32
+	Longitude     float32
+	Altitude      int
+	Heading       *int
+	GroundSpeed   int
+	VerticalSpeed int
+	Time          time.Time
+}
+
+// ADSCPositionProvider returns the aircraft's current state for an outgoing ADS-C report.
+type ADSCPositionProvider func() ADSCReport
+
+// ADSCContractType distinguishes how an ADS-C contract schedules its reports.
+type ADSCContractType string
+
+const (
+	// ADSCPeriodicContract reports at a fixed Interval.
+	ADSCPeriodicContract ADSCContractType = "periodic"
+	// ADSCEventContract reports whenever the host app calls ReportEvent for its Station.
+	// ReportEvent does not compare the contract's Event; the host app decides when it fired.
+	ADSCEventContract ADSCContractType = "event"
+	// ADSCOnDemandContract sends a single report, then is cancelled automatically.
+	ADSCOnDemandContract ADSCContractType = "demand"
+)
+
+// ADSCContract is a single contract held with a downlink/uplink station.
+type ADSCContract struct {
+	Station string
+	Type    ADSCContractType
+	// Interval applies to ADSCPeriodicContract only
+	Interval time.Duration
+	// Event applies to ADSCEventContract only, e.g. "waypoint" or "altitude-change"
+	Event string
+}
+
+// ADSCContractState describes a transition a contract has just gone through.
+type ADSCContractState int
+
+const (
+	ADSCContractEstablished ADSCContractState = iota
+	ADSCContractCancelled
+)
+
+// ADSCContractTransition is emitted on ADSCReporter.Transitions whenever a contract is
+// established or cancelled, either by the host app or by the connected station.
+type ADSCContractTransition struct {
+	Contract ADSCContract
+	State    ADSCContractState
+}
+
+// ADSCReporter manages ADS-C contracts and sends periodic/event/on-demand position
+// reports for a single ACARSManager. Obtain one via ACARSManager.ADSCReporter once
+// ACARSManagerOptions.AdsCReporting is enabled.
+type ADSCReporter struct {
+	mutex sync.Mutex
+
+	manager  *ACARSManager
+	provider ADSCPositionProvider
+
+	contracts   map[string]*ADSCContract
+	transitions chan ADSCContractTransition
+	events      chan adscEvent
+}
+
+// adscEvent requests an immediate report to station. oneShot is set for an
+// ADSCOnDemandContract's triggering report, so run can cancel it once sent, without
+// ever entering it into contracts (where it could otherwise evict an in-force
+// periodic/event contract for the same station).
+type adscEvent struct {
+	station string
+	oneShot *ADSCContract
+}
+
+func newADSCReporter(m *ACARSManager) *ADSCReporter {
+	return &ADSCReporter{
+		manager:     m,
+		contracts:   make(map[string]*ADSCContract),
+		transitions: make(chan ADSCContractTransition, 8),
+		events:      make(chan adscEvent, 8),
+	}
+}
+
+// SetPositionProvider registers the function the reporter calls to build each outgoing
+// report. Reports are dropped silently until a provider is set.
+func (r *ADSCReporter) SetPositionProvider(provider ADSCPositionProvider) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.provider = provider
+}
+
+// Transitions exposes ADS-C contract establish/cancel events, separate from RecvState's
+// CPDLC connection states. The channel is optional and buffered (cap 8); if it fills up
+// because nothing is draining it, further transitions are dropped rather than blocking
+// Listen's poll loop.
+func (r *ADSCReporter) Transitions() <-chan ADSCContractTransition {
+	return r.transitions
+}
+
+// RequestContract establishes a periodic contract with station, replacing any existing
+// contract for it, or triggers a single on-demand report without disturbing an
+// in-force contract for station. Event contracts are established via inbound "REQUEST
+// ADS-C EVENT ..." CPDLC messages or by calling RequestEventContract directly.
+func (r *ADSCReporter) RequestContract(station string, contractType ADSCContractType, interval time.Duration) {
+	contract := &ADSCContract{Station: station, Type: contractType, Interval: interval}
+
+	if contractType == ADSCOnDemandContract {
+		r.emitTransition(ADSCContractTransition{Contract: *contract, State: ADSCContractEstablished})
+
+		select {
+		case r.events <- adscEvent{station: station, oneShot: contract}:
+		default:
+		}
+
+		return
+	}
+
+	r.mutex.Lock()
+	r.contracts[station] = contract
+	r.mutex.Unlock()
+
+	r.emitTransition(ADSCContractTransition{Contract: *contract, State: ADSCContractEstablished})
+}
+
+// RequestEventContract establishes an event contract with station for the given trigger
+// (e.g. "waypoint", "altitude-change"). ReportEvent sends a report whenever that trigger fires.
+func (r *ADSCReporter) RequestEventContract(station, event string) {
+	contract := &ADSCContract{Station: station, Type: ADSCEventContract, Event: event}
+
+	r.mutex.Lock()
+	r.contracts[station] = contract
+	r.mutex.Unlock()
+
+	r.emitTransition(ADSCContractTransition{Contract: *contract, State: ADSCContractEstablished})
+}
+
+// CancelContract cancels any contract held with station.
+func (r *ADSCReporter) CancelContract(station string) {
+	r.mutex.Lock()
+	contract, ok := r.contracts[station]
+	delete(r.contracts, station)
+	r.mutex.Unlock()
+
+	if ok {
+		r.emitTransition(ADSCContractTransition{Contract: *contract, State: ADSCContractCancelled})
+	}
+}
+
+// emitTransition sends on transitions without blocking: Transitions() is an optional
+// channel the host app may never drain, and this is reached synchronously from Listen
+// via handleContractRequest, so a blocking send here would deadlock the poll loop.
+func (r *ADSCReporter) emitTransition(t ADSCContractTransition) {
+	select {
+	case r.transitions <- t:
+	default:
+		r.manager.opts.logger.Warn("Transitions channel full, dropping ADS-C contract transition", "Station", t.Contract.Station, "State", t.State)
+	}
+}
+
+// ReportEvent triggers an immediate report to station, when the host app detects an
+// event contract's trigger (waypoint passage, altitude change).
+func (r *ADSCReporter) ReportEvent(station string) {
+	select {
+	case r.events <- adscEvent{station: station}:
+	default:
+	}
+}
+
+// handleContractRequest interprets a downlinked CPDLC free-text message as an ADS-C
+// contract request/cancellation. Returns whether data was recognised as one.
+func (r *ADSCReporter) handleContractRequest(station, data string) bool {
+	switch {
+	case strings.HasPrefix(data, "REQUEST ADS-C PERIODIC "):
+		seconds, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(data, "REQUEST ADS-C PERIODIC ")))
+		if err != nil {
+			return false
+		}
+		r.RequestContract(station, ADSCPeriodicContract, time.Duration(seconds)*time.Second)
+		return true
+	case strings.HasPrefix(data, "REQUEST ADS-C EVENT "):
+		r.RequestEventContract(station, strings.ToLower(strings.TrimSpace(strings.TrimPrefix(data, "REQUEST ADS-C EVENT "))))
+		return true
+	case data == "REQUEST ADS-C DEMAND":
+		r.RequestContract(station, ADSCOnDemandContract, 0)
+		return true
+	case data == "CANCEL ADS-C":
+		r.CancelContract(station)
+		return true
+	default:
+		return false
+	}
+}
+
+// run drives periodic reports and on-demand/event triggers until ctx is done. Launched
+// as a manager goroutine from Connect when ACARSManagerOptions.AdsCReporting is enabled.
+func (r *ADSCReporter) run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	lastSent := make(map[string]time.Time)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-r.events:
+			r.send(ev.station)
+			if ev.oneShot != nil {
+				r.emitTransition(ADSCContractTransition{Contract: *ev.oneShot, State: ADSCContractCancelled})
+			}
+		case <-ticker.C:
+			r.mutex.Lock()
+			contracts := make([]ADSCContract, 0, len(r.contracts))
+			for _, c := range r.contracts {
+				contracts = append(contracts, *c)
+			}
+			r.mutex.Unlock()
+
+			now := time.Now()
+			for _, c := range contracts {
+				if c.Type != ADSCPeriodicContract || c.Interval <= 0 {
+					continue
+				}
+				if now.Sub(lastSent[c.Station]) >= c.Interval {
+					r.send(c.Station)
+					lastSent[c.Station] = now
+				}
+			}
+		}
+	}
+}
+
+func (r *ADSCReporter) send(station string) {
+	r.mutex.Lock()
+	provider := r.provider
+	r.mutex.Unlock()
+
+	if provider == nil {
+		return
+	}
+
+	report := provider()
+	packet := formatADSCReport(*r.manager.callsign, report)
+
+	if _, err := r.manager.rawRequest(station, AdsCMessageType, packet); err != nil {
+		r.manager.opts.logger.Warn("Failed to send ADS-C report", "Station", station, "error", err)
+	}
+}
+
+// formatADSCReport writes report using the same "REPORT ..." wire format ParseAdsCMessage
+// decodes, so reports this library sends round-trip through its own parser.
+//
+// This intentionally diverges from /data2/-framed CPDLC packets: /data2/ carries a
+// MIN/MRN/RRK downlink exchange (see MakeCPDLCPacket), which ADS-C reports have no
+// equivalent of, and ParseAdsCMessage - already shipped, unrelated to ADS-C reporting -
+// only understands the plain "REPORT ..." body. Framing reports as /data2/ packets would
+// make them unparseable by this library's own receiving side.
+func formatADSCReport(callsign string, report ADSCReport) string {
+	fields := []string{
+		callsign,
+		report.Time.UTC().Format("150405"),
+		strconv.FormatFloat(float64(report.Latitude), 'f', 4, 32),
+		strconv.FormatFloat(float64(report.Longitude), 'f', 4, 32),
+		strconv.Itoa(report.Altitude),
+	}
+
+	if report.Heading != nil {
+		fields = append(fields, strconv.Itoa(*report.Heading))
+	}
+
+	return "REPORT " + strings.Join(fields, " ")
+}
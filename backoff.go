@@ -0,0 +1,69 @@
+package hoppielibgo
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// BackoffConfig controls the delay between ACARS polls and reconnect attempts.
+//
+// Each retry's delay is BaseDelay * Factor^retries, capped at MaxDelay, then perturbed
+// by +/- Jitter (a fraction, e.g. 0.2 for +/-20%).
+type BackoffConfig struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	Factor    float64
+	Jitter    float64
+}
+
+// DefaultBackoffConfig is used when ACARSManagerOptions.Backoff is never called.
+//
+// BaseDelay is also the floor for an actively exchanging session: idleRetries resets to
+// 0 on every received message, so the poll loop falls straight back to BaseDelay rather
+// than continuing to grow. It intentionally diverges from the 1s BaseDelay floated when
+// backoff was first proposed: BaseDelay is a steady-state poll floor, not a one-off
+// startup delay, and a 1s floor lets a busy session poll hoppie.nl sixty times as often
+// as the legacy ~60s PollInterval baseline did. 60s keeps that baseline; only idle
+// sessions back off further, up to MaxDelay. Listen fires its first poll immediately
+// regardless of BaseDelay, so this floor doesn't delay LOGON-ACCEPTED detection -
+// callers wanting a tighter steady-state cadence than 60s can still override via
+// ACARSManagerOptions.Backoff or PollInterval.
+var DefaultBackoffConfig = BackoffConfig{
+	BaseDelay: 60 * time.Second,
+	MaxDelay:  120 * time.Second,
+	Factor:    1.6,
+	Jitter:    0.2,
+}
+
+// backoffStrategy computes the delay before the next poll/reconnect attempt, given how
+// many retries (or, in the poll loop, how many consecutive empty polls) have occurred.
+type backoffStrategy interface {
+	Backoff(retries int) time.Duration
+}
+
+// exponentialBackoff is the default backoffStrategy, implementing BackoffConfig.
+type exponentialBackoff struct {
+	cfg BackoffConfig
+}
+
+func newExponentialBackoff(cfg BackoffConfig) *exponentialBackoff {
+	return &exponentialBackoff{cfg: cfg}
+}
+
+func (b *exponentialBackoff) Backoff(retries int) time.Duration {
+	delay := float64(b.cfg.BaseDelay) * math.Pow(b.cfg.Factor, float64(retries))
+	if max := float64(b.cfg.MaxDelay); b.cfg.MaxDelay > 0 && delay > max {
+		delay = max
+	}
+
+	if b.cfg.Jitter > 0 {
+		delay *= 1 + b.cfg.Jitter*(rand.Float64()*2-1)
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+
+	return time.Duration(delay)
+}
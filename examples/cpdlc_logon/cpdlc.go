@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	lib "github.com/devHazz/hoppielib-go"
+	"github.com/devHazz/hoppielib-go/hoppietest"
 )
 
 // This example is structured around having 2 goroutines, which is handled by the ACARSManager ErrGroup to handle errors with concurrency
@@ -21,6 +22,7 @@ func main() {
 	logon := flag.String("logon", "", "Hoppie Logon Code")
 	sender := flag.String("tx", "", "Sender station (Your callsign)")
 	receiver := flag.String("rx", "", "Receiving station")
+	embedded := flag.Bool("embedded", false, "Run entirely offline against an in-process hoppietest server instead of the real hoppie.nl")
 
 	flag.Parse()
 
@@ -33,19 +35,48 @@ func main() {
 	opts.PollInterval(30)
 	// opts.LogonTimeout(10)
 
+	if *embedded {
+		*logon, *sender, *receiver = "TEST", "TEST123", "WLS2"
+
+		server := hoppietest.New()
+		defer server.Close()
+
+		opts.BaseURL(server.URL + "/acars/system/connect.html")
+
+		// Script WLS2 accepting our upcoming REQUEST LOGON (MIN 1) ahead of time
+		server.Enqueue(*sender, hoppietest.Frame{
+			Station: *receiver,
+			Type:    "cpdlc",
+			Payload: "/data2/2/1/NE/LOGON ACCEPTED",
+		})
+
+		log.Info().Str("URL", server.URL).Msg("Running fully offline against an embedded hoppietest server")
+	}
+
 	manager := lib.NewACARSManager(*logon, *sender, opts)
 
+	// Archive every message to a rotating log file, in addition to the zerolog output below
+	if sink, err := lib.SinkFactory(lib.SinkConfig{
+		Type:       lib.FilesystemSinkType,
+		Filename:   "acars.log",
+		MaxAgeDays: 7,
+		MaxBackups: 5,
+		MaxSizeMB:  10,
+	}); err == nil {
+		manager.AddSink(sink)
+	}
+
 	// Setup CPDLC Connection with Receiving Station by sending a REQUEST LOGON message to WLS2
 	if err := manager.Connect(*receiver); err != nil {
 		log.Error().Err(err).Msg("Manager Connect Error")
 	}
 
-	// manager.ErrGroup.Go(func() error {
-	// 	return manager.OnConnected(func() error {
-	// 		// Make a generic request once connected to REQUEST CLIMB TO FL330
-	// 		return manager.CPDLCRequest("REQUEST CLIMB TO FL330", lib.RespondRequired)
-	// 	})
-	// })
+	manager.ErrGroup.Go(func() error {
+		return manager.OnConnected(func() error {
+			// Make a generic request once connected to REQUEST CLIMB TO FL330
+			return manager.CPDLCRequest("REQUEST CLIMB TO FL330", lib.RespondRequired)
+		})
+	})
 
 	// Spin up goroutine for processing incoming messages
 	manager.ErrGroup.Go(func() error {
@@ -6,8 +6,16 @@ import (
 	"net/http"
 )
 
+// GetStatusNotams fetches Status.Notams from the real hoppie.nl status.html. Use
+// GetStatusNotamsAt to override the URL, e.g. towards an in-process hoppietest server.
 func GetStatusNotams(client *http.Client) ([]string, error) {
-	r, e := client.Get(StatusRequestUrl)
+	return GetStatusNotamsAt(client, StatusRequestUrl)
+}
+
+// GetStatusNotamsAt is GetStatusNotams against an arbitrary status.html URL, so tests can
+// point it at an in-process hoppietest server instead of the real hoppie.nl.
+func GetStatusNotamsAt(client *http.Client, statusURL string) ([]string, error) {
+	r, e := client.Get(statusURL)
 	if e != nil {
 		return nil, fmt.Errorf("failed to fetch hoppie status: %w", e)
 	}
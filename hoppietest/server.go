@@ -0,0 +1,176 @@
+// Package hoppietest provides an in-process HTTP server implementing enough of the
+// Hoppie ACARS connect/poll/peek protocol (and status.html) to drive ACARSManager,
+// CPDLCRequest, ParseCPDLCMessage, ParseAdsCMessage and GetStatusNotams from tests and
+// examples without touching the real hoppie.nl network.
+package hoppietest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// Frame is a single inbound {CALLSIGN type {payload}} message, queued to be delivered
+// to station on its next poll/peek.
+type Frame struct {
+	Station string
+	Type    string
+	Payload string
+}
+
+// Post captures a single request made against connect.html, for assertions in tests.
+type Post struct {
+	Logon  string
+	From   string
+	To     string
+	Type   string
+	Packet string
+}
+
+// StatusResponse is the JSON document served from status.html.
+type StatusResponse struct {
+	StatusCode     string   `json:"status_code"`
+	SystemTime     string   `json:"system_time"`
+	Message        string   `json:"message,omitempty"`
+	LoadPercentage float32  `json:"system_load_percent"`
+	Notams         []string `json:"notams"`
+}
+
+// Server is an in-process fake of the Hoppie ACARS system, embedding an
+// httptest.Server. Use Server.URL + "/acars/system/connect.html" as
+// ACARSManagerOptions.BaseURL.
+type Server struct {
+	*httptest.Server
+
+	mutex sync.Mutex
+
+	inbound          map[string][]Frame
+	malformed        map[string]string
+	suppressedLogons map[string]bool
+	posts            []Post
+	status           StatusResponse
+}
+
+// New starts a hoppietest Server. Call Close when finished.
+func New() *Server {
+	s := &Server{
+		inbound:          make(map[string][]Frame),
+		malformed:        make(map[string]string),
+		suppressedLogons: make(map[string]bool),
+		status: StatusResponse{
+			StatusCode: "ok",
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/acars/system/connect.html", s.handleConnect)
+	mux.HandleFunc("/acars/system/status.html", s.handleStatus)
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Enqueue schedules an inbound frame to be delivered to station on its next poll/peek.
+func (s *Server) Enqueue(station string, frame Frame) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.inbound[station] = append(s.inbound[station], frame)
+}
+
+// EnqueueMalformed schedules a raw, unparseable CPDLC payload for station, to exercise
+// ErrInvalidCPDLCFormat/ErrInvalidFieldCount handling.
+func (s *Server) EnqueueMalformed(station, raw string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.malformed[station] = raw
+}
+
+// SimulateLogonTimeout makes polls/peeks from station (the polling callsign) discard
+// every frame queued for it instead of delivering it, so a LOGON ACCEPTED reply never
+// arrives and callers can exercise ACARSManagerOptions.LogonTimeout. Frames are not
+// filtered by payload: every queued frame for station is dropped, not only logon replies.
+func (s *Server) SimulateLogonTimeout(station string, enable bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.suppressedLogons[station] = enable
+}
+
+// SetLoadPercentage controls the system_load_percent field returned from status.html.
+func (s *Server) SetLoadPercentage(pct float32) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.status.LoadPercentage = pct
+}
+
+// SetNotams controls the notams field returned from status.html.
+func (s *Server) SetNotams(notams []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.status.Notams = notams
+}
+
+// Posts returns every request made against connect.html so far, for test assertions.
+func (s *Server) Posts() []Post {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Post(nil), s.posts...)
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	status := s.status
+	s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(status)
+}
+
+func (s *Server) handleConnect(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	post := Post{
+		Logon:  q.Get("logon"),
+		From:   q.Get("from"),
+		To:     q.Get("to"),
+		Type:   q.Get("type"),
+		Packet: q.Get("packet"),
+	}
+
+	s.mutex.Lock()
+	s.posts = append(s.posts, post)
+	s.mutex.Unlock()
+
+	switch post.Type {
+	case "poll", "peek":
+		s.writeInbound(w, post.From)
+	default:
+		fmt.Fprint(w, "ok")
+	}
+}
+
+func (s *Server) writeInbound(w http.ResponseWriter, station string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if raw, ok := s.malformed[station]; ok {
+		delete(s.malformed, station)
+		fmt.Fprintf(w, "ok {%s cpdlc {%s}}", station, raw)
+		return
+	}
+
+	frames := s.inbound[station]
+	s.inbound[station] = nil
+
+	var body string
+	for _, f := range frames {
+		if s.suppressedLogons[station] {
+			continue
+		}
+		body += fmt.Sprintf("{%s %s {%s}}", f.Station, f.Type, f.Payload)
+	}
+
+	fmt.Fprintf(w, "ok %s", body)
+}
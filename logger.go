@@ -0,0 +1,75 @@
+package hoppielibgo
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Logger is the minimal logging surface ACARSManager needs. Implement it to bridge to
+// slog, logrus, log15, a test buffer, or anything else instead of pulling zerolog's
+// global logger into your binary.
+//
+// kv is a flat list of alternating string keys and values, e.g.
+// logger.Info("Logon Successful", "Station", station).
+type Logger interface {
+	Debug(msg string, kv ...interface{})
+	Info(msg string, kv ...interface{})
+	Warn(msg string, kv ...interface{})
+	Error(msg string, kv ...interface{})
+}
+
+// zerologLogger is the default Logger, backed by a zerolog.Logger.
+type zerologLogger struct {
+	logger zerolog.Logger
+}
+
+// NewZerologLogger adapts a zerolog.Logger to the Logger interface.
+func NewZerologLogger(logger zerolog.Logger) Logger {
+	return &zerologLogger{logger: logger}
+}
+
+func (l *zerologLogger) Debug(msg string, kv ...interface{}) {
+	l.log(l.logger.Debug(), msg, kv)
+}
+
+func (l *zerologLogger) Info(msg string, kv ...interface{}) {
+	l.log(l.logger.Info(), msg, kv)
+}
+
+func (l *zerologLogger) Warn(msg string, kv ...interface{}) {
+	l.log(l.logger.Warn(), msg, kv)
+}
+
+func (l *zerologLogger) Error(msg string, kv ...interface{}) {
+	l.log(l.logger.Error(), msg, kv)
+}
+
+func (l *zerologLogger) log(event *zerolog.Event, msg string, kv []interface{}) {
+	for i := 0; i+1 < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			continue
+		}
+		event = event.Interface(key, kv[i+1])
+	}
+	event.Msg(msg)
+}
+
+// defaultLogger logs through zerolog's global logger, matching this library's prior
+// behaviour for callers that never set ACARSManagerOptions.Logger.
+func defaultLogger() Logger {
+	return NewZerologLogger(log.Logger)
+}
+
+// noopLogger discards everything, for callers that want no logging at all.
+type noopLogger struct{}
+
+// NewNoopLogger returns a Logger that discards every call.
+func NewNoopLogger() Logger {
+	return noopLogger{}
+}
+
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Warn(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
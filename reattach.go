@@ -0,0 +1,110 @@
+package hoppielibgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+)
+
+// HoppieReattachEnv is the environment variable NewACARSManager checks for a
+// ReattachConfig, to attach to an ACARS session already owned by another process
+// (e.g. a cockpit sim plugin already talking to hoppie.nl) instead of logging on itself.
+const HoppieReattachEnv = "HOPPIE_REATTACH"
+
+// ReattachConfig describes an externally managed ACARS session for a manager to attach
+// to. Marshal it into HoppieReattachEnv from the host process that owns the session.
+type ReattachConfig struct {
+	Logon   string `json:"logon"`
+	Sender  string `json:"sender"`
+	BaseURL string `json:"base_url,omitempty"`
+	// Cookie, if set, is sent as the Cookie header on every outbound request, so the
+	// manager shares HTTP state (e.g. a load-balancer affinity cookie) with the external
+	// session it's attaching to.
+	Cookie string `json:"cookie,omitempty"`
+	// Socket, if set, is a "unix:///path/to.sock" address to proxy requests through
+	// instead of dialing BaseURL directly over the default transport.
+	Socket string `json:"socket,omitempty"`
+}
+
+// NewReattachConfig produces a ReattachConfig a host app can marshal into
+// HoppieReattachEnv for a child/plugin process to pick up.
+func NewReattachConfig(logon, sender string) ReattachConfig {
+	return ReattachConfig{Logon: logon, Sender: sender}
+}
+
+// JSON marshals the config for HoppieReattachEnv.
+func (c ReattachConfig) JSON() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("reattach: failed to marshal config: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// reattachConfigFromEnv reads and parses HoppieReattachEnv, returning nil if unset.
+func reattachConfigFromEnv() (*ReattachConfig, error) {
+	raw := os.Getenv(HoppieReattachEnv)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var cfg ReattachConfig
+	if err := json.Unmarshal([]byte(raw), &cfg); err != nil {
+		return nil, fmt.Errorf("reattach: failed to parse %s: %w", HoppieReattachEnv, err)
+	}
+
+	return &cfg, nil
+}
+
+// unixSocketClient builds an *http.Client that dials socket for every request,
+// regardless of the URL's host, for proxying through a host app's unix socket.
+func unixSocketClient(socket string) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+}
+
+// cookieRoundTripper sets Cookie on every outbound request before delegating to next,
+// so a manager attached via ReattachConfig.Cookie shares HTTP state with the session
+// it's attaching to.
+type cookieRoundTripper struct {
+	cookie string
+	next   http.RoundTripper
+}
+
+func (t *cookieRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Cookie", t.cookie)
+
+	return t.next.RoundTrip(req)
+}
+
+// withReattachCookie wraps client so every request it sends carries cookie, for
+// ReattachConfig.Cookie. client may be nil, in which case http.DefaultClient's
+// transport is wrapped.
+func withReattachCookie(client *http.Client, cookie string) *http.Client {
+	if client == nil {
+		client = &http.Client{}
+	} else {
+		wrapped := *client
+		client = &wrapped
+	}
+
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	client.Transport = &cookieRoundTripper{cookie: cookie, next: next}
+
+	return client
+}
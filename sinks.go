@@ -0,0 +1,299 @@
+package hoppielibgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SinkDirection marks whether a message handed to a Sink was received from or sent to
+// a station.
+type SinkDirection string
+
+const (
+	SinkDirectionInbound  SinkDirection = "inbound"
+	SinkDirectionOutbound SinkDirection = "outbound"
+)
+
+// Sink receives every inbound ACARSMessage polled by Listen and every outbound
+// CPDLCRequest/Telex message, in addition to normal Recv delivery, so callers can
+// archive or replay traffic without consuming the message channel themselves.
+// Connect's REQUEST LOGON, WeatherRequest replies and ADS-C reports are not passed to
+// sinks.
+//
+// cpdlc and adsc are populated when the message data could be decoded as such, and are
+// nil otherwise.
+type Sink interface {
+	// Name identifies the sink, primarily for logging.
+	Name() string
+	HandleMessage(direction SinkDirection, message ACARSMessage, cpdlc *CPDLCMessage, adsc *ADSCMessage)
+}
+
+// AddSink registers a Sink to receive the inbound/outbound ACARSMessages described on
+// Sink, in addition to normal Recv delivery.
+func (m *ACARSManager) AddSink(s Sink) {
+	m.sinks = append(m.sinks, s)
+}
+
+// dispatchToSinks opportunistically decodes message as CPDLC/ADS-C before fanning it
+// out to every registered sink.
+func (m *ACARSManager) dispatchToSinks(direction SinkDirection, message ACARSMessage) {
+	if len(m.sinks) == 0 {
+		return
+	}
+
+	var cpdlc *CPDLCMessage
+	var adsc *ADSCMessage
+
+	switch message.Type {
+	case CpdlcMessageType:
+		if parsed, err := ParseCPDLCMessage(message.Data); err == nil {
+			cpdlc = parsed
+		}
+	case AdsCMessageType:
+		if parsed, err := ParseAdsCMessage(message.Data); err == nil {
+			adsc = parsed
+		}
+	}
+
+	for _, sink := range m.sinks {
+		sink.HandleMessage(direction, message, cpdlc, adsc)
+	}
+}
+
+// ConsoleSink writes a one-line summary of every message to an io.Writer, typically
+// os.Stdout or os.Stderr.
+type ConsoleSink struct {
+	out io.Writer
+}
+
+func NewConsoleSink(out io.Writer) *ConsoleSink {
+	return &ConsoleSink{out: out}
+}
+
+func (s *ConsoleSink) Name() string {
+	return "console"
+}
+
+func (s *ConsoleSink) HandleMessage(direction SinkDirection, message ACARSMessage, cpdlc *CPDLCMessage, adsc *ADSCMessage) {
+	fmt.Fprintf(s.out, "[%s] %s type=%s data=%q\n", direction, message.Sender, message.Type, message.Data)
+}
+
+// sinkLogLine is the JSON representation a FilesystemSink writes, one per line, so the
+// resulting file can be replayed or analysed offline.
+type sinkLogLine struct {
+	Time      time.Time     `json:"time"`
+	Direction SinkDirection `json:"direction"`
+	Sender    string        `json:"sender"`
+	Type      MessageType   `json:"type"`
+	Data      string        `json:"data"`
+	CPDLC     *CPDLCMessage `json:"cpdlc,omitempty"`
+	ADSC      *ADSCMessage  `json:"adsc,omitempty"`
+}
+
+// FilesystemSink writes newline-delimited JSON to Filename, rotating the file once it
+// exceeds MaxSizeMB and pruning rotated backups by MaxAgeDays/MaxBackups.
+type FilesystemSink struct {
+	mutex sync.Mutex
+
+	Filename   string
+	MaxAgeDays int
+	MaxBackups int
+	MaxSizeMB  int
+
+	file *os.File
+	size int64
+}
+
+func NewFilesystemSink(filename string, maxAgeDays, maxBackups, maxSizeMB int) (*FilesystemSink, error) {
+	if filename == "" {
+		return nil, errors.New("sinks: filesystem sink requires a filename")
+	}
+
+	s := &FilesystemSink{
+		Filename:   filename,
+		MaxAgeDays: maxAgeDays,
+		MaxBackups: maxBackups,
+		MaxSizeMB:  maxSizeMB,
+	}
+
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *FilesystemSink) Name() string {
+	return "filesystem"
+}
+
+func (s *FilesystemSink) open() error {
+	if dir := filepath.Dir(s.Filename); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("sinks: failed to create log directory: %w", err)
+		}
+	}
+
+	f, err := os.OpenFile(s.Filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("sinks: failed to open log file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("sinks: failed to stat log file: %w", err)
+	}
+
+	s.file = f
+	s.size = info.Size()
+
+	return nil
+}
+
+func (s *FilesystemSink) HandleMessage(direction SinkDirection, message ACARSMessage, cpdlc *CPDLCMessage, adsc *ADSCMessage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	line, err := json.Marshal(sinkLogLine{
+		Time:      time.Now(),
+		Direction: direction,
+		Sender:    message.Sender,
+		Type:      message.Type,
+		Data:      message.Data,
+		CPDLC:     cpdlc,
+		ADSC:      adsc,
+	})
+	if err != nil {
+		return
+	}
+
+	line = append(line, '\n')
+
+	if s.MaxSizeMB > 0 && s.size+int64(len(line)) > int64(s.MaxSizeMB)*1024*1024 {
+		if err := s.rotate(); err != nil {
+			return
+		}
+	}
+
+	n, err := s.file.Write(line)
+	if err == nil {
+		s.size += int64(n)
+	}
+}
+
+func (s *FilesystemSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	backup := fmt.Sprintf("%s.%s", s.Filename, time.Now().Format("20060102T150405"))
+	if err := os.Rename(s.Filename, backup); err != nil {
+		return err
+	}
+
+	s.pruneBackups()
+
+	return s.open()
+}
+
+// pruneBackups removes rotated files older than MaxAgeDays, then trims whatever
+// remains down to MaxBackups, oldest first.
+func (s *FilesystemSink) pruneBackups() {
+	dir := filepath.Dir(s.Filename)
+	base := filepath.Base(s.Filename)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	var backups []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), base+".") {
+			continue
+		}
+		backups = append(backups, filepath.Join(dir, e.Name()))
+	}
+
+	sort.Strings(backups)
+
+	if s.MaxAgeDays > 0 {
+		now := time.Now()
+		kept := backups[:0]
+
+		for _, b := range backups {
+			info, err := os.Stat(b)
+			if err != nil {
+				continue
+			}
+			if now.Sub(info.ModTime()) > time.Duration(s.MaxAgeDays)*24*time.Hour {
+				os.Remove(b)
+				continue
+			}
+			kept = append(kept, b)
+		}
+
+		backups = kept
+	}
+
+	if s.MaxBackups > 0 && len(backups) > s.MaxBackups {
+		for _, b := range backups[:len(backups)-s.MaxBackups] {
+			os.Remove(b)
+		}
+	}
+}
+
+func (s *FilesystemSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.file.Close()
+}
+
+// SinkType selects which Sink implementation SinkFactory produces.
+type SinkType string
+
+const (
+	ConsoleSinkType    SinkType = "console"
+	FilesystemSinkType SinkType = "filesystem"
+)
+
+// SinkConfig is the config struct consumed by SinkFactory. Only the fields relevant to
+// Type need to be set.
+type SinkConfig struct {
+	Type SinkType
+
+	// ConsoleOutput selects where a ConsoleSinkType writes. Defaults to os.Stdout.
+	ConsoleOutput *os.File
+
+	// Filesystem-backed sink parameters, only used for FilesystemSinkType.
+	Filename   string
+	MaxAgeDays int
+	MaxBackups int
+	MaxSizeMB  int
+}
+
+// SinkFactory builds a Sink from a SinkConfig, so apps can swap sink implementations
+// through config rather than constructing them by hand.
+func SinkFactory(cfg SinkConfig) (Sink, error) {
+	switch cfg.Type {
+	case ConsoleSinkType:
+		out := cfg.ConsoleOutput
+		if out == nil {
+			out = os.Stdout
+		}
+		return NewConsoleSink(out), nil
+	case FilesystemSinkType:
+		return NewFilesystemSink(cfg.Filename, cfg.MaxAgeDays, cfg.MaxBackups, cfg.MaxSizeMB)
+	default:
+		return nil, fmt.Errorf("sinks: unknown sink type %q", cfg.Type)
+	}
+}
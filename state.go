@@ -0,0 +1,143 @@
+package hoppielibgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Session is the persisted CPDLC connection state a StateStore saves/loads, so a
+// restarted process can Resume an in-progress session instead of losing the MIN
+// counter and breaking MRN matching with the connected station.
+type Session struct {
+	Callsign string
+	Station  string
+	LastMin  int
+	LastMrn  *int
+	State    ConnectionState
+}
+
+// StateStore persists a single ACARSManager's Session across restarts, keyed by
+// callsign. Ship implementations: InMemoryStateStore (the default) and
+// FileStateStore; plug in Redis, SQL etc by implementing this interface.
+type StateStore interface {
+	// LoadSession returns the persisted Session for callsign, or nil if none exists.
+	LoadSession(callsign string) (*Session, error)
+	SaveSession(session *Session) error
+	ClearSession(callsign string) error
+}
+
+// InMemoryStateStore is the default StateStore: sessions live only as long as the
+// process, matching this library's behaviour before StateStore existed.
+type InMemoryStateStore struct {
+	mutex    sync.Mutex
+	sessions map[string]Session
+}
+
+func NewInMemoryStateStore() *InMemoryStateStore {
+	return &InMemoryStateStore{sessions: make(map[string]Session)}
+}
+
+func (s *InMemoryStateStore) LoadSession(callsign string) (*Session, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	session, ok := s.sessions[callsign]
+	if !ok {
+		return nil, nil
+	}
+
+	return &session, nil
+}
+
+func (s *InMemoryStateStore) SaveSession(session *Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sessions[session.Callsign] = *session
+
+	return nil
+}
+
+func (s *InMemoryStateStore) ClearSession(callsign string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.sessions, callsign)
+
+	return nil
+}
+
+// FileStateStore persists one JSON file per callsign under Dir, so a Session survives
+// a process restart.
+type FileStateStore struct {
+	mutex sync.Mutex
+
+	Dir string
+}
+
+func NewFileStateStore(dir string) (*FileStateStore, error) {
+	if dir == "" {
+		return nil, errors.New("state: file state store requires a directory")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("state: failed to create state directory: %w", err)
+	}
+
+	return &FileStateStore{Dir: dir}, nil
+}
+
+func (s *FileStateStore) path(callsign string) string {
+	return filepath.Join(s.Dir, callsign+".json")
+}
+
+func (s *FileStateStore) LoadSession(callsign string) (*Session, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	data, err := os.ReadFile(s.path(callsign))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("state: failed to read session for %s: %w", callsign, err)
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("state: failed to parse session for %s: %w", callsign, err)
+	}
+
+	return &session, nil
+}
+
+func (s *FileStateStore) SaveSession(session *Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	data, err := json.MarshalIndent(session, "", "  ")
+	if err != nil {
+		return fmt.Errorf("state: failed to marshal session for %s: %w", session.Callsign, err)
+	}
+
+	if err := os.WriteFile(s.path(session.Callsign), data, 0o644); err != nil {
+		return fmt.Errorf("state: failed to write session for %s: %w", session.Callsign, err)
+	}
+
+	return nil
+}
+
+func (s *FileStateStore) ClearSession(callsign string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := os.Remove(s.path(callsign)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("state: failed to clear session for %s: %w", callsign, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package hoppielibgo
+
+// DropPolicy controls what happens when a subscription's buffer is full and Listen has
+// another message to fan out to it: drop the oldest buffered message to make room, or
+// drop the new one.
+type DropPolicy int
+
+const (
+	DropOldest DropPolicy = iota
+	DropNewest
+)
+
+// defaultSubscriberBufferSize is used when a Subscribe call doesn't set WithBufferSize.
+const defaultSubscriberBufferSize = 16
+
+// subscription is a single Subscribe registration: a channel plus the filters a
+// message must pass before Listen fans it out to ch.
+type subscription struct {
+	id         uint64
+	ch         chan ACARSMessage
+	types      map[MessageType]struct{}
+	senders    map[string]struct{}
+	predicate  func(ACARSMessage) bool
+	dropPolicy DropPolicy
+}
+
+func (s *subscription) matches(message ACARSMessage) bool {
+	if len(s.types) > 0 {
+		if _, ok := s.types[message.Type]; !ok {
+			return false
+		}
+	}
+
+	if len(s.senders) > 0 {
+		if _, ok := s.senders[message.Sender]; !ok {
+			return false
+		}
+	}
+
+	if s.predicate != nil && !s.predicate(message) {
+		return false
+	}
+
+	return true
+}
+
+// SubscribeOption configures a Subscribe call.
+type SubscribeOption func(*subscription)
+
+// WithMessageType restricts the subscription to the given MessageTypes. With none set,
+// messages of every type match.
+func WithMessageType(types ...MessageType) SubscribeOption {
+	return func(s *subscription) {
+		for _, t := range types {
+			s.types[t] = struct{}{}
+		}
+	}
+}
+
+// WithSender restricts the subscription to messages sent by the given callsigns. With
+// none set, messages from every sender match.
+func WithSender(senders ...string) SubscribeOption {
+	return func(s *subscription) {
+		for _, sender := range senders {
+			s.senders[sender] = struct{}{}
+		}
+	}
+}
+
+// WithBufferSize sets the subscription channel's buffer capacity, overriding
+// defaultSubscriberBufferSize.
+func WithBufferSize(n int) SubscribeOption {
+	return func(s *subscription) {
+		if n > 0 {
+			s.ch = make(chan ACARSMessage, n)
+		}
+	}
+}
+
+// WithPredicate adds an arbitrary filter, evaluated in addition to WithMessageType and
+// WithSender.
+func WithPredicate(predicate func(ACARSMessage) bool) SubscribeOption {
+	return func(s *subscription) {
+		s.predicate = predicate
+	}
+}
+
+// WithDropPolicy controls what Listen does when this subscription's buffer is already
+// full. Defaults to DropOldest.
+func WithDropPolicy(policy DropPolicy) SubscribeOption {
+	return func(s *subscription) {
+		s.dropPolicy = policy
+	}
+}
+
+// Subscribe registers a filtered view of inbound ACARSMessages. Listen fans every
+// parsed message out to every matching subscription without blocking: a full buffer
+// drops a message per the subscription's DropPolicy instead of stalling the poll loop,
+// unlike Recv's unfiltered, unbuffered-beyond-1 channel.
+//
+// The returned func unsubscribes and closes the channel; callers should call it once
+// done receiving, typically via defer.
+func (m *ACARSManager) Subscribe(opts ...SubscribeOption) (<-chan ACARSMessage, func()) {
+	sub := &subscription{
+		ch:         make(chan ACARSMessage, defaultSubscriberBufferSize),
+		types:      make(map[MessageType]struct{}),
+		senders:    make(map[string]struct{}),
+		dropPolicy: DropOldest,
+	}
+
+	for _, opt := range opts {
+		opt(sub)
+	}
+
+	m.subsMutex.Lock()
+	m.subID++
+	sub.id = m.subID
+	m.subscribers[sub.id] = sub
+	m.subsMutex.Unlock()
+
+	unsubscribe := func() {
+		m.subsMutex.Lock()
+		defer m.subsMutex.Unlock()
+
+		if _, ok := m.subscribers[sub.id]; ok {
+			delete(m.subscribers, sub.id)
+			close(sub.ch)
+		}
+	}
+
+	return sub.ch, unsubscribe
+}
+
+// dispatchToSubscribers fans message out to every matching Subscribe subscription. A
+// subscription whose buffer is full has a message dropped per its DropPolicy rather
+// than blocking, so one stalled subscriber cannot stall Listen's poll loop.
+func (m *ACARSManager) dispatchToSubscribers(message ACARSMessage) {
+	m.subsMutex.Lock()
+	defer m.subsMutex.Unlock()
+
+	for _, sub := range m.subscribers {
+		if !sub.matches(message) {
+			continue
+		}
+
+		select {
+		case sub.ch <- message:
+			continue
+		default:
+		}
+
+		if sub.dropPolicy == DropOldest {
+			select {
+			case <-sub.ch:
+			default:
+			}
+
+			select {
+			case sub.ch <- message:
+			default:
+			}
+		}
+	}
+}